@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ozeemandias/auth/internal/config"
+	"github.com/ozeemandias/auth/internal/mailer"
+	repoPasswordReset "github.com/ozeemandias/auth/internal/repository/passwordreset"
+	repoUser "github.com/ozeemandias/auth/internal/repository/user"
+	userservice "github.com/ozeemandias/auth/internal/service/user"
+	"github.com/ozeemandias/auth/internal/session"
+	grpcUser "github.com/ozeemandias/auth/internal/transport/grpc/user"
+	"github.com/ozeemandias/auth/internal/transport/interceptors"
+	"github.com/ozeemandias/auth/pkg/user_v1"
+	"github.com/valkey-io/valkey-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// App is the composition root: it owns every long-lived resource (listener, connection
+// pools, grpc server) and is responsible for starting and draining them in order.
+type App struct {
+	listener     net.Listener
+	grpcServer   *grpc.Server
+	dbpool       *pgxpool.Pool
+	valkeyClient valkey.Client
+}
+
+// NewApp wires config -> pgxpool/valkey -> repositories -> services -> grpc server. envPath
+// is the path to the .env file to load (see cmd/main.go's -env flag).
+func NewApp(ctx context.Context, envPath string) (*App, error) {
+	if err := config.Load(envPath); err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	grpcConfig, err := config.NewGRPCConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grpc config: %w", err)
+	}
+
+	pgConfig, err := config.NewPGConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pg config: %w", err)
+	}
+
+	valkeyConfig, err := config.NewValkeyConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get valkey config: %w", err)
+	}
+
+	totpConfig, err := config.NewTOTPConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get totp config: %w", err)
+	}
+
+	mailerConfig, err := config.NewMailerConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mailer config: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", grpcConfig.Address())
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	dbpool, err := pgxpool.New(ctx, pgConfig.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create connection pool: %w", err)
+	}
+
+	valkeyClient, err := valkey.NewClient(valkey.ClientOption{InitAddress: []string{valkeyConfig.Address()}})
+	if err != nil {
+		dbpool.Close()
+		return nil, fmt.Errorf("unable to create valkey client: %w", err)
+	}
+
+	sessionStore := session.NewValkeyStore(valkeyClient)
+
+	userRepo := repoUser.NewPGRepository(dbpool)
+	resetRepo := repoPasswordReset.NewPGRepository(dbpool)
+
+	userService := userservice.NewService(userRepo)
+	resetter := userservice.NewPasswordResetter(userService, resetRepo, newMailSender(mailerConfig), mailerConfig.ResetURLBase())
+
+	handler := grpcUser.NewHandler(userService, resetter, sessionStore, totpConfig.EncryptionKey())
+
+	grpcServer := grpc.NewServer(interceptors.ServerOptions(sessionStore)...)
+	reflection.Register(grpcServer)
+	user_v1.RegisterUserV1Server(grpcServer, handler)
+
+	return &App{
+		listener:     listener,
+		grpcServer:   grpcServer,
+		dbpool:       dbpool,
+		valkeyClient: valkeyClient,
+	}, nil
+}
+
+// Run serves until the process receives SIGINT/SIGTERM, then drains in-flight RPCs and
+// releases every resource NewApp acquired.
+func (a *App) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.Printf("server listening at %v", a.listener.Addr())
+		serveErr <- a.grpcServer.Serve(a.listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		a.Stop()
+		return err
+	case <-ctx.Done():
+		log.Print("shutting down gracefully")
+		a.Stop()
+		return nil
+	}
+}
+
+// Stop drains in-flight RPCs and releases every resource acquired by NewApp. Safe to call
+// more than once.
+func (a *App) Stop() {
+	a.grpcServer.GracefulStop()
+	a.dbpool.Close()
+	a.valkeyClient.Close()
+}
+
+func newMailSender(cfg config.MailerConfig) mailer.Sender {
+	if cfg.SMTPHost() == "" {
+		return mailer.NewLogSender()
+	}
+
+	return mailer.NewSMTPSender(cfg.SMTPHost(), cfg.SMTPPort(), cfg.SMTPUser(), cfg.SMTPPassword(), cfg.From())
+}
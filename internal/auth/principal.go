@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/internal/model"
+)
+
+// Principal identifies the caller a request is being made on behalf of, as resolved by the
+// auth interceptor from the bearer access token.
+type Principal struct {
+	UserID int64
+	Role   model.Role
+}
+
+type principalCtxKey struct{}
+
+// NewContextWithPrincipal returns a copy of ctx carrying principal.
+func NewContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalCtxKey{}, principal)
+}
+
+// PrincipalFromContext returns the Principal stashed by the auth interceptor, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalCtxKey{}).(Principal)
+	return principal, ok
+}
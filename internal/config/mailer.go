@@ -0,0 +1,63 @@
+package config
+
+import (
+	"errors"
+	"os"
+)
+
+const (
+	smtpHostEnvName     = "SMTP_HOST"
+	smtpPortEnvName     = "SMTP_PORT"
+	smtpUserEnvName     = "SMTP_USER"
+	smtpPasswordEnvName = "SMTP_PASSWORD"
+	smtpFromEnvName     = "SMTP_FROM"
+	resetURLBaseEnvName = "PASSWORD_RESET_URL_BASE"
+)
+
+// MailerConfig exposes the SMTP connection details and the base URL used to build
+// password-reset links.
+type MailerConfig interface {
+	SMTPHost() string
+	SMTPPort() string
+	SMTPUser() string
+	SMTPPassword() string
+	From() string
+	ResetURLBase() string
+}
+
+type mailerConfig struct {
+	smtpHost     string
+	smtpPort     string
+	smtpUser     string
+	smtpPassword string
+	from         string
+	resetURLBase string
+}
+
+func NewMailerConfig() (*mailerConfig, error) {
+	from := os.Getenv(smtpFromEnvName)
+	if from == "" {
+		return nil, errors.New("smtp from address not found")
+	}
+
+	resetURLBase := os.Getenv(resetURLBaseEnvName)
+	if resetURLBase == "" {
+		return nil, errors.New("password reset url base not found")
+	}
+
+	return &mailerConfig{
+		smtpHost:     os.Getenv(smtpHostEnvName),
+		smtpPort:     os.Getenv(smtpPortEnvName),
+		smtpUser:     os.Getenv(smtpUserEnvName),
+		smtpPassword: os.Getenv(smtpPasswordEnvName),
+		from:         from,
+		resetURLBase: resetURLBase,
+	}, nil
+}
+
+func (cfg *mailerConfig) SMTPHost() string     { return cfg.smtpHost }
+func (cfg *mailerConfig) SMTPPort() string     { return cfg.smtpPort }
+func (cfg *mailerConfig) SMTPUser() string     { return cfg.smtpUser }
+func (cfg *mailerConfig) SMTPPassword() string { return cfg.smtpPassword }
+func (cfg *mailerConfig) From() string         { return cfg.from }
+func (cfg *mailerConfig) ResetURLBase() string { return cfg.resetURLBase }
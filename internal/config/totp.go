@@ -0,0 +1,42 @@
+package config
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const totpEncryptionKeyEnvName = "TOTP_ENCRYPTION_KEY"
+
+// TOTPConfig exposes the key used to encrypt TOTP secrets at rest.
+type TOTPConfig interface {
+	EncryptionKey() []byte
+}
+
+type totpConfig struct {
+	encryptionKey []byte
+}
+
+// NewTOTPConfig reads a 32-byte AES-256 key, hex-encoded, from TOTP_ENCRYPTION_KEY.
+func NewTOTPConfig() (*totpConfig, error) {
+	raw := os.Getenv(totpEncryptionKeyEnvName)
+	if raw == "" {
+		return nil, errors.New("totp encryption key not found")
+	}
+
+	key, err := hex.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode totp encryption key: %w", err)
+	}
+
+	if len(key) != 32 {
+		return nil, errors.New("totp encryption key must be 32 bytes")
+	}
+
+	return &totpConfig{encryptionKey: key}, nil
+}
+
+func (cfg *totpConfig) EncryptionKey() []byte {
+	return cfg.encryptionKey
+}
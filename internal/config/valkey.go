@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	valkeyHostEnvName = "VALKEY_HOST"
+	valkeyPortEnvName = "VALKEY_PORT"
+)
+
+// ValkeyConfig exposes the connection details for the session store.
+type ValkeyConfig interface {
+	Address() string
+}
+
+type valkeyConfig struct {
+	host string
+	port string
+}
+
+func NewValkeyConfig() (*valkeyConfig, error) {
+	host := os.Getenv(valkeyHostEnvName)
+	if host == "" {
+		return nil, errors.New("valkey host not found")
+	}
+
+	port := os.Getenv(valkeyPortEnvName)
+	if port == "" {
+		return nil, errors.New("valkey port not found")
+	}
+
+	return &valkeyConfig{host: host, port: port}, nil
+}
+
+func (cfg *valkeyConfig) Address() string {
+	return fmt.Sprintf("%s:%s", cfg.host, cfg.port)
+}
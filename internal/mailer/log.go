@@ -0,0 +1,19 @@
+package mailer
+
+import (
+	"context"
+	"log"
+)
+
+// LogSender writes messages to the standard logger instead of delivering them. Intended for
+// local development so password-reset links etc. are still visible without SMTP configured.
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(_ context.Context, msg Message) error {
+	log.Printf("mailer: would send to %q, subject %q:\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
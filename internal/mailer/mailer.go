@@ -0,0 +1,16 @@
+package mailer
+
+import "context"
+
+// Message is a single outgoing email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers a Message. Swappable so the service can run against real SMTP in production
+// and a no-op logger in dev/tests.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
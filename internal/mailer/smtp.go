@@ -0,0 +1,36 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPSender delivers messages through a standard SMTP relay.
+type SMTPSender struct {
+	host string
+	port string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPSender(host, port, username, password, from string) *SMTPSender {
+	return &SMTPSender{
+		host: host,
+		port: port,
+		from: from,
+		auth: smtp.PlainAuth("", username, password, host),
+	}
+}
+
+func (s *SMTPSender) Send(_ context.Context, msg Message) error {
+	addr := fmt.Sprintf("%s:%s", s.host, s.port)
+
+	body := fmt.Sprintf("To: %s\r\nSubject: %s\r\n\r\n%s", msg.To, msg.Subject, msg.Body)
+
+	if err := smtp.SendMail(addr, s.auth, s.from, []string{msg.To}, []byte(body)); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", msg.To, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+var passwordResetTmpl = template.Must(template.ParseFS(templatesFS, "templates/password_reset.txt.tmpl"))
+
+// PasswordResetData is the data available to the password-reset email template.
+type PasswordResetData struct {
+	ResetURL string
+}
+
+// RenderPasswordReset renders the password-reset email body for data.
+func RenderPasswordReset(data PasswordResetData) (string, error) {
+	var buf bytes.Buffer
+	if err := passwordResetTmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render password reset email: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,14 @@
+package model
+
+import "errors"
+
+var (
+	// ErrNotFound is returned when a lookup by id/email matches no row.
+	ErrNotFound = errors.New("user not found")
+	// ErrAlreadyExists is returned when a create would violate a uniqueness constraint (email).
+	ErrAlreadyExists = errors.New("user already exists")
+	// ErrInvalidCredentials is returned by authentication flows on a bad email/password pair.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrInvalidTOTPCode is returned when a 6-digit TOTP code (or recovery code) doesn't verify.
+	ErrInvalidTOTPCode = errors.New("invalid totp code")
+)
@@ -0,0 +1,39 @@
+package model
+
+import "strings"
+
+// Role is the domain representation of a user's permission level, independent of how the
+// transport layer happens to encode it (proto enum, SQL text column, ...).
+type Role int32
+
+const (
+	RoleUnspecified Role = iota
+	RoleUser
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleUnspecified: "unspecified",
+	RoleUser:        "user",
+	RoleAdmin:       "admin",
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+
+	return roleNames[RoleUnspecified]
+}
+
+// ParseRole maps a case-insensitive role name (as stored in Postgres) back to a Role,
+// defaulting to RoleUnspecified for anything it doesn't recognize.
+func ParseRole(s string) Role {
+	for role, name := range roleNames {
+		if strings.EqualFold(name, s) {
+			return role
+		}
+	}
+
+	return RoleUnspecified
+}
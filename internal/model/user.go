@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// User is the domain representation of a row in the users table.
+type User struct {
+	ID              int64
+	Name            string
+	Email           string
+	PasswordHash    string
+	Role            Role
+	TOTPSecret      string
+	TOTPConfirmedAt *time.Time
+	RecoveryCodes   []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// HasTOTP reports whether the user has completed TOTP enrollment.
+func (u *User) HasTOTP() bool {
+	return u.TOTPConfirmedAt != nil
+}
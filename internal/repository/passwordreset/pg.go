@@ -0,0 +1,56 @@
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrNotFound = errors.New("password reset: token not found, used or expired")
+
+type pgRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewPGRepository(dbpool *pgxpool.Pool) *pgRepository {
+	return &pgRepository{dbpool: dbpool}
+}
+
+func (r *pgRepository) Create(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error {
+	_, err := r.dbpool.Exec(ctx,
+		`INSERT INTO password_resets (user_id, token_hash, expires_at) VALUES ($1, $2, $3)`,
+		userID, tokenHash, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to store reset token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) FindValid(ctx context.Context, tokenHash string) (Reset, error) {
+	var reset Reset
+	err := r.dbpool.QueryRow(ctx,
+		`SELECT id, user_id FROM password_resets WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()`,
+		tokenHash).Scan(&reset.ID, &reset.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Reset{}, ErrNotFound
+		}
+		return Reset{}, fmt.Errorf("failed to look up reset token: %w", err)
+	}
+
+	return reset, nil
+}
+
+func (r *pgRepository) MarkUsed(ctx context.Context, id int64) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE password_resets SET used_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark reset token used: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package passwordreset
+
+import (
+	"context"
+	"time"
+)
+
+// Reset is a single row of the password_resets table.
+type Reset struct {
+	ID     int64
+	UserID int64
+}
+
+// Repository is the persistence boundary for password-reset tokens.
+type Repository interface {
+	// Create stores tokenHash for userID, valid until expiresAt.
+	Create(ctx context.Context, userID int64, tokenHash string, expiresAt time.Time) error
+	// FindValid looks up an unused, unexpired reset by its token hash.
+	FindValid(ctx context.Context, tokenHash string) (Reset, error)
+	// MarkUsed marks a reset row consumed so it can't be replayed.
+	MarkUsed(ctx context.Context, id int64) error
+}
@@ -0,0 +1,204 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ozeemandias/auth/internal/model"
+)
+
+const uniqueViolationCode = "23505"
+
+// pgRepository is a Repository backed by Postgres, built with squirrel.
+type pgRepository struct {
+	dbpool *pgxpool.Pool
+}
+
+func NewPGRepository(dbpool *pgxpool.Pool) *pgRepository {
+	return &pgRepository{dbpool: dbpool}
+}
+
+// role scans a nullable users.role text column straight into a model.Role.
+type role model.Role
+
+func (dest *role) Scan(v interface{}) error {
+	ns := sql.NullString{}
+	if err := ns.Scan(v); err != nil {
+		return err
+	}
+
+	if !ns.Valid {
+		*dest = role(model.RoleUnspecified)
+		return nil
+	}
+
+	*dest = role(model.ParseRole(ns.String))
+
+	return nil
+}
+
+func (r *pgRepository) Create(ctx context.Context, u *model.User) (int64, error) {
+	query, args, err := sq.Insert("users").
+		Columns("name", "email", "password", "role").
+		Values(u.Name, u.Email, u.PasswordHash, u.Role.String()).
+		PlaceholderFormat(sq.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var id int64
+	err = r.dbpool.QueryRow(ctx, query, args...).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return 0, model.ErrAlreadyExists
+		}
+		return 0, fmt.Errorf("failed to insert user: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *pgRepository) Get(ctx context.Context, id int64) (*model.User, error) {
+	return r.scanOne(ctx, sq.Eq{"id": id})
+}
+
+func (r *pgRepository) GetByEmail(ctx context.Context, email string) (*model.User, error) {
+	return r.scanOne(ctx, sq.Eq{"email": email})
+}
+
+func (r *pgRepository) scanOne(ctx context.Context, pred sq.Eq) (*model.User, error) {
+	query, args, err := sq.Select("id", "name", "email", "password", "role",
+		"totp_secret", "totp_confirmed_at", "recovery_codes", "created_at", "updated_at").
+		From("users").
+		Where(pred).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	var u model.User
+	var roleVal role
+	var totpSecret sql.NullString
+
+	err = r.dbpool.QueryRow(ctx, query, args...).Scan(
+		&u.ID, &u.Name, &u.Email, &u.PasswordHash, &roleVal,
+		&totpSecret, &u.TOTPConfirmedAt, &u.RecoveryCodes, &u.CreatedAt, &u.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, model.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to select user: %w", err)
+	}
+
+	u.Role = model.Role(roleVal)
+	u.TOTPSecret = totpSecret.String
+
+	return &u, nil
+}
+
+func (r *pgRepository) Update(ctx context.Context, id int64, patch UpdatePatch) error {
+	builder := sq.Update("users").
+		Where(sq.Eq{"id": id}).
+		Set("updated_at", sq.Expr("NOW()")).
+		PlaceholderFormat(sq.Dollar)
+
+	if patch.Email != nil {
+		builder = builder.Set("email", *patch.Email)
+	}
+
+	if patch.Name != nil {
+		builder = builder.Set("name", *patch.Name)
+	}
+
+	if patch.Role != nil {
+		builder = builder.Set("role", patch.Role.String())
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.dbpool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) UpdatePassword(ctx context.Context, id int64, passwordHash string) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE users SET password = $1, updated_at = NOW() WHERE id = $2`, passwordHash, id)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) Delete(ctx context.Context, id int64) error {
+	query, args, err := sq.Delete("users").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build query: %w", err)
+	}
+
+	_, err = r.dbpool.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) SetTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE users SET totp_secret = $1, totp_confirmed_at = NULL WHERE id = $2`, encryptedSecret, id)
+	if err != nil {
+		return fmt.Errorf("failed to persist totp secret: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) ConfirmTOTP(ctx context.Context, id int64, hashedRecoveryCodes []string) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE users SET totp_confirmed_at = NOW(), recovery_codes = $1 WHERE id = $2`,
+		hashedRecoveryCodes, id)
+	if err != nil {
+		return fmt.Errorf("failed to confirm totp: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) DisableTOTP(ctx context.Context, id int64) error {
+	_, err := r.dbpool.Exec(ctx,
+		`UPDATE users SET totp_secret = NULL, totp_confirmed_at = NULL, recovery_codes = '{}' WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to disable totp: %w", err)
+	}
+
+	return nil
+}
+
+func (r *pgRepository) ConsumeRecoveryCode(ctx context.Context, id int64, remaining []string) error {
+	_, err := r.dbpool.Exec(ctx, `UPDATE users SET recovery_codes = $1 WHERE id = $2`, remaining, id)
+	if err != nil {
+		return fmt.Errorf("failed to consume recovery code: %w", err)
+	}
+
+	return nil
+}
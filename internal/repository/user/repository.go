@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/internal/model"
+)
+
+// UpdatePatch carries the optional fields an Update call may change. A nil field is left
+// untouched.
+type UpdatePatch struct {
+	Name  *string
+	Email *string
+	Role  *model.Role
+}
+
+// Repository is the persistence boundary for the user domain.
+type Repository interface {
+	Create(ctx context.Context, u *model.User) (int64, error)
+	Get(ctx context.Context, id int64) (*model.User, error)
+	GetByEmail(ctx context.Context, email string) (*model.User, error)
+	Update(ctx context.Context, id int64, patch UpdatePatch) error
+	UpdatePassword(ctx context.Context, id int64, passwordHash string) error
+	Delete(ctx context.Context, id int64) error
+
+	SetTOTPSecret(ctx context.Context, id int64, encryptedSecret string) error
+	ConfirmTOTP(ctx context.Context, id int64, hashedRecoveryCodes []string) error
+	DisableTOTP(ctx context.Context, id int64) error
+	ConsumeRecoveryCode(ctx context.Context, id int64, remaining []string) error
+}
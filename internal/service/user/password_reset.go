@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/ozeemandias/auth/internal/mailer"
+	repoReset "github.com/ozeemandias/auth/internal/repository/passwordreset"
+)
+
+const passwordResetTTL = 30 * time.Minute
+
+// PasswordResetter wires the user service to the password-reset token store and mailer.
+type PasswordResetter struct {
+	users        *Service
+	resets       repoReset.Repository
+	mailSender   mailer.Sender
+	resetURLBase string
+}
+
+func NewPasswordResetter(users *Service, resets repoReset.Repository, mailSender mailer.Sender, resetURLBase string) *PasswordResetter {
+	return &PasswordResetter{users: users, resets: resets, mailSender: mailSender, resetURLBase: resetURLBase}
+}
+
+// Request looks up the account by email and, if it exists, emails a single-use reset link in
+// the background. It always succeeds immediately and never reports whether the email was
+// known, to avoid account enumeration by either response or timing.
+func (p *PasswordResetter) Request(ctx context.Context, email string) {
+	u, err := p.users.repo.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return
+	}
+
+	go p.sendResetEmail(u.ID, u.Email)
+}
+
+// sendResetEmail generates and stores a reset token and emails it, off the request path. Every
+// failure is logged and swallowed rather than surfaced, since by the time it runs the RPC that
+// triggered it has already responded.
+func (p *PasswordResetter) sendResetEmail(userID int64, email string) {
+	ctx := context.Background()
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		log.Printf("password reset: failed to generate token for user %d: %v", userID, err)
+		return
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	if err := p.resets.Create(ctx, userID, hashToken(token), time.Now().Add(passwordResetTTL)); err != nil {
+		log.Printf("password reset: failed to store token for user %d: %v", userID, err)
+		return
+	}
+
+	body, err := mailer.RenderPasswordReset(mailer.PasswordResetData{ResetURL: p.resetURLBase + token})
+	if err != nil {
+		log.Printf("password reset: failed to render email for user %d: %v", userID, err)
+		return
+	}
+
+	if err := p.mailSender.Send(ctx, mailer.Message{To: email, Subject: "Reset your password", Body: body}); err != nil {
+		log.Printf("password reset: failed to send email for user %d: %v", userID, err)
+	}
+}
+
+// Confirm validates token, sets newPassword and consumes the reset row. It returns the id of
+// the user whose password changed, so the caller can revoke their sessions.
+func (p *PasswordResetter) Confirm(ctx context.Context, token, newPassword string) (int64, error) {
+	reset, err := p.resets.FindValid(ctx, hashToken(token))
+	if err != nil {
+		return 0, err
+	}
+
+	passwordHash, err := hashAndSalt(newPassword)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.users.repo.UpdatePassword(ctx, reset.UserID, passwordHash); err != nil {
+		return 0, err
+	}
+
+	if err := p.resets.MarkUsed(ctx, reset.ID); err != nil {
+		return 0, err
+	}
+
+	return reset.UserID, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
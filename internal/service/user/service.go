@@ -0,0 +1,99 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ozeemandias/auth/internal/model"
+	repoUser "github.com/ozeemandias/auth/internal/repository/user"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Service implements the user domain's business rules (trimming, lowercasing, hashing,
+// validation) on top of a Repository. It deliberately knows nothing about gRPC or SQL.
+type Service struct {
+	repo repoUser.Repository
+}
+
+func NewService(repo repoUser.Repository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) Create(ctx context.Context, name, email, password string, role model.Role) (int64, error) {
+	passwordHash, err := hashAndSalt(password)
+	if err != nil {
+		return 0, err
+	}
+
+	u := &model.User{
+		Name:         strings.TrimSpace(name),
+		Email:        normalizeEmail(email),
+		PasswordHash: passwordHash,
+		Role:         role,
+	}
+
+	return s.repo.Create(ctx, u)
+}
+
+func (s *Service) Get(ctx context.Context, id int64) (*model.User, error) {
+	return s.repo.Get(ctx, id)
+}
+
+func (s *Service) Update(ctx context.Context, id int64, name, email *string, role model.Role) error {
+	patch := repoUser.UpdatePatch{}
+
+	if name != nil {
+		if trimmed := strings.TrimSpace(*name); trimmed != "" {
+			patch.Name = &trimmed
+		}
+	}
+
+	if email != nil {
+		if normalized := normalizeEmail(*email); normalized != "" {
+			patch.Email = &normalized
+		}
+	}
+
+	if role != model.RoleUnspecified {
+		patch.Role = &role
+	}
+
+	return s.repo.Update(ctx, id, patch)
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// VerifyPassword looks up email and checks password against the stored hash, returning the
+// user on success and model.ErrInvalidCredentials otherwise (never distinguishing "no such
+// user" from "wrong password" to the caller).
+func (s *Service) VerifyPassword(ctx context.Context, email, password string) (*model.User, error) {
+	u, err := s.repo.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		return nil, model.ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)); err != nil {
+		return nil, model.ErrInvalidCredentials
+	}
+
+	return u, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func hashAndSalt(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func bcryptCompare(hash, plain string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(plain)) == nil
+}
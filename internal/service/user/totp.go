@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/internal/model"
+	"github.com/ozeemandias/auth/internal/totp"
+)
+
+// EnrollTOTP mints a fresh secret for the user, encrypts it and persists it unconfirmed.
+func (s *Service) EnrollTOTP(ctx context.Context, totpKey []byte, id int64) (secret, otpauthURI string, err error) {
+	u, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return "", "", err
+	}
+
+	secret, otpauthURI, err = totp.GenerateSecret(u.Email)
+	if err != nil {
+		return "", "", err
+	}
+
+	encryptedSecret, err := totp.Encrypt(totpKey, secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := s.repo.SetTOTPSecret(ctx, id, encryptedSecret); err != nil {
+		return "", "", err
+	}
+
+	return secret, otpauthURI, nil
+}
+
+// ConfirmTOTP validates code against the enrolled (unconfirmed) secret and, on success,
+// confirms TOTP and returns a fresh batch of recovery codes.
+func (s *Service) ConfirmTOTP(ctx context.Context, totpKey []byte, id int64, code string) ([]string, error) {
+	u, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.Decrypt(totpKey, u.TOTPSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if !totp.Validate(secret, code) {
+		return nil, model.ErrInvalidTOTPCode
+	}
+
+	recoveryCodes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	hashedCodes := make([]string, len(recoveryCodes))
+	for i, rc := range recoveryCodes {
+		hash, err := hashAndSalt(rc)
+		if err != nil {
+			return nil, err
+		}
+		hashedCodes[i] = hash
+	}
+
+	if err := s.repo.ConfirmTOTP(ctx, id, hashedCodes); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+// DisableTOTP validates code against the confirmed secret and, on success, turns TOTP off.
+func (s *Service) DisableTOTP(ctx context.Context, totpKey []byte, id int64, code string) error {
+	u, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	secret, err := totp.Decrypt(totpKey, u.TOTPSecret)
+	if err != nil {
+		return err
+	}
+
+	if !totp.Validate(secret, code) {
+		return model.ErrInvalidTOTPCode
+	}
+
+	return s.repo.DisableTOTP(ctx, id)
+}
+
+// VerifyTOTPCode checks code against the user's confirmed secret, falling back to matching
+// (and consuming) a recovery code. It returns the user on success.
+func (s *Service) VerifyTOTPCode(ctx context.Context, totpKey []byte, id int64, code string) (*model.User, error) {
+	u, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.Decrypt(totpKey, u.TOTPSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if totp.Validate(secret, code) {
+		return u, nil
+	}
+
+	if idx := matchRecoveryCode(u.RecoveryCodes, code); idx >= 0 {
+		remaining := append(u.RecoveryCodes[:idx], u.RecoveryCodes[idx+1:]...)
+		if err := s.repo.ConsumeRecoveryCode(ctx, id, remaining); err != nil {
+			return nil, err
+		}
+		return u, nil
+	}
+
+	return nil, model.ErrInvalidTOTPCode
+}
+
+func matchRecoveryCode(hashedCodes []string, code string) int {
+	for i, hash := range hashedCodes {
+		if bcryptCompare(hash, code) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,52 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when a token is unknown, expired or has already been rotated/revoked.
+	ErrNotFound = errors.New("session: token not found or expired")
+)
+
+// TokenPair is the pair of tokens handed back to a client after a successful Login or Refresh.
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+// Session is the data kept alongside an access/refresh token.
+type Session struct {
+	UserID    int64
+	Role      int32
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	Device    string
+}
+
+// Store mints, resolves and revokes sessions. Implementations persist state out of process
+// (Valkey/Redis) so that any instance of the service can validate a token.
+type Store interface {
+	// Create mints a fresh access/refresh token pair for userID and persists both.
+	Create(ctx context.Context, userID int64, role int32, device string) (TokenPair, error)
+	// Resolve looks up the session behind an access token.
+	Resolve(ctx context.Context, accessToken string) (Session, error)
+	// Rotate exchanges a refresh token for a new token pair, invalidating the old refresh token.
+	Rotate(ctx context.Context, refreshToken string) (TokenPair, error)
+	// Revoke deletes a single session (both its access and refresh token) identified by its
+	// refresh token.
+	Revoke(ctx context.Context, refreshToken string) error
+	// RevokeAllForUser deletes every session (access + refresh) belonging to userID.
+	RevokeAllForUser(ctx context.Context, userID int64) error
+
+	// IssuePreAuthToken mints a short-lived token identifying a user who has passed the first
+	// login factor but still owes a second one (e.g. TOTP).
+	IssuePreAuthToken(ctx context.Context, userID int64, role int32) (string, error)
+	// ConsumePreAuthToken resolves and deletes a pre-auth token in one step, so it can only
+	// ever be used once.
+	ConsumePreAuthToken(ctx context.Context, token string) (Session, error)
+}
@@ -0,0 +1,283 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+	preAuthTokenTTL = 5 * time.Minute
+
+	accessKeyPrefix  = "session:access:"
+	refreshKeyPrefix = "session:refresh:"
+	preAuthKeyPrefix = "session:preauth:"
+	userIndexPrefix  = "sessions:user:"
+
+	accessIndexTag  = "access:"
+	refreshIndexTag = "refresh:"
+)
+
+type record struct {
+	UserID    int64     `json:"user_id"`
+	Role      int32     `json:"role"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Device    string    `json:"device"`
+	// PairedAccessHash is set on a refresh record to the hash of the access token minted
+	// alongside it, so revoking the refresh token can also revoke its access token.
+	PairedAccessHash string `json:"paired_access_hash,omitempty"`
+}
+
+// ValkeyStore is a Store backed by Valkey/Redis. Access and refresh tokens are kept under
+// separate key prefixes with their own TTLs; both are additionally indexed under
+// sessions:user:<id> (tagged access:/refresh:) so that every session for a user can be
+// enumerated and revoked together.
+type ValkeyStore struct {
+	client valkey.Client
+}
+
+func NewValkeyStore(client valkey.Client) *ValkeyStore {
+	return &ValkeyStore{client: client}
+}
+
+func (s *ValkeyStore) Create(ctx context.Context, userID int64, role int32, device string) (TokenPair, error) {
+	accessToken, err := newOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	refreshToken, err := newOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	now := time.Now()
+	accessHash := hashToken(accessToken)
+	refreshHash := hashToken(refreshToken)
+
+	accessRec := record{UserID: userID, Role: role, IssuedAt: now, ExpiresAt: now.Add(accessTokenTTL), Device: device}
+	if err := s.set(ctx, accessKeyPrefix+accessHash, accessRec, accessTokenTTL); err != nil {
+		return TokenPair{}, err
+	}
+
+	refreshRec := record{UserID: userID, Role: role, IssuedAt: now, ExpiresAt: now.Add(refreshTokenTTL), Device: device, PairedAccessHash: accessHash}
+	if err := s.set(ctx, refreshKeyPrefix+refreshHash, refreshRec, refreshTokenTTL); err != nil {
+		return TokenPair{}, err
+	}
+
+	if err := s.indexToken(ctx, userID, accessIndexTag, accessHash); err != nil {
+		return TokenPair{}, err
+	}
+	if err := s.indexToken(ctx, userID, refreshIndexTag, refreshHash); err != nil {
+		return TokenPair{}, err
+	}
+
+	return TokenPair{
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		AccessExpiresAt:  accessRec.ExpiresAt,
+		RefreshExpiresAt: refreshRec.ExpiresAt,
+	}, nil
+}
+
+func (s *ValkeyStore) Resolve(ctx context.Context, accessToken string) (Session, error) {
+	rec, err := s.get(ctx, accessKeyPrefix+hashToken(accessToken))
+	if err != nil {
+		return Session{}, err
+	}
+
+	return Session{UserID: rec.UserID, Role: rec.Role, IssuedAt: rec.IssuedAt, ExpiresAt: rec.ExpiresAt, Device: rec.Device}, nil
+}
+
+func (s *ValkeyStore) Rotate(ctx context.Context, refreshToken string) (TokenPair, error) {
+	oldKey := refreshKeyPrefix + hashToken(refreshToken)
+
+	rec, err := s.get(ctx, oldKey)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	if err := s.del(ctx, oldKey); err != nil {
+		return TokenPair{}, err
+	}
+
+	if err := s.unindexToken(ctx, rec.UserID, refreshIndexTag, hashToken(refreshToken)); err != nil {
+		return TokenPair{}, err
+	}
+
+	if rec.PairedAccessHash != "" {
+		if err := s.del(ctx, accessKeyPrefix+rec.PairedAccessHash); err != nil {
+			return TokenPair{}, err
+		}
+		if err := s.unindexToken(ctx, rec.UserID, accessIndexTag, rec.PairedAccessHash); err != nil {
+			return TokenPair{}, err
+		}
+	}
+
+	return s.Create(ctx, rec.UserID, rec.Role, rec.Device)
+}
+
+// Revoke deletes the session identified by refreshToken along with the access token minted
+// alongside it, so neither can be used again.
+func (s *ValkeyStore) Revoke(ctx context.Context, refreshToken string) error {
+	key := refreshKeyPrefix + hashToken(refreshToken)
+
+	rec, err := s.get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.del(ctx, key); err != nil {
+		return err
+	}
+
+	if err := s.unindexToken(ctx, rec.UserID, refreshIndexTag, hashToken(refreshToken)); err != nil {
+		return err
+	}
+
+	if rec.PairedAccessHash == "" {
+		return nil
+	}
+
+	if err := s.del(ctx, accessKeyPrefix+rec.PairedAccessHash); err != nil {
+		return err
+	}
+
+	return s.unindexToken(ctx, rec.UserID, accessIndexTag, rec.PairedAccessHash)
+}
+
+// RevokeAllForUser deletes every access and refresh token indexed for userID, using the tags
+// recorded by indexToken to know which key prefix each hash belongs under.
+func (s *ValkeyStore) RevokeAllForUser(ctx context.Context, userID int64) error {
+	indexKey := s.userIndexKey(userID)
+
+	members, err := s.client.Do(ctx, s.client.B().Smembers().Key(indexKey).Build()).AsStrSlice()
+	if err != nil {
+		return fmt.Errorf("failed to list sessions for user %d: %w", userID, err)
+	}
+
+	keys := make([]string, 0, len(members)+1)
+	for _, m := range members {
+		switch {
+		case strings.HasPrefix(m, accessIndexTag):
+			keys = append(keys, accessKeyPrefix+strings.TrimPrefix(m, accessIndexTag))
+		case strings.HasPrefix(m, refreshIndexTag):
+			keys = append(keys, refreshKeyPrefix+strings.TrimPrefix(m, refreshIndexTag))
+		}
+	}
+	keys = append(keys, indexKey)
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := s.client.Do(ctx, s.client.B().Del().Key(keys...).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to revoke sessions for user %d: %w", userID, err)
+	}
+
+	return nil
+}
+
+func (s *ValkeyStore) IssuePreAuthToken(ctx context.Context, userID int64, role int32) (string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	rec := record{UserID: userID, Role: role, IssuedAt: now, ExpiresAt: now.Add(preAuthTokenTTL)}
+
+	if err := s.set(ctx, preAuthKeyPrefix+hashToken(token), rec, preAuthTokenTTL); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *ValkeyStore) ConsumePreAuthToken(ctx context.Context, token string) (Session, error) {
+	key := preAuthKeyPrefix + hashToken(token)
+
+	raw, err := s.client.Do(ctx, s.client.B().Getdel().Key(key).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return Session{}, ErrNotFound
+		}
+		return Session{}, fmt.Errorf("failed to consume pre-auth token: %w", err)
+	}
+
+	var rec record
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return Session{}, fmt.Errorf("failed to unmarshal session record: %w", err)
+	}
+
+	return Session{UserID: rec.UserID, Role: rec.Role, IssuedAt: rec.IssuedAt, ExpiresAt: rec.ExpiresAt}, nil
+}
+
+func (s *ValkeyStore) userIndexKey(userID int64) string {
+	return fmt.Sprintf("%s%d", userIndexPrefix, userID)
+}
+
+// indexToken records hash (prefixed with tag, one of accessIndexTag/refreshIndexTag) under
+// userID's index set, so RevokeAllForUser can find and delete it later. The index key's own
+// TTL always tracks refreshTokenTTL, the longest-lived entry it can ever hold.
+func (s *ValkeyStore) indexToken(ctx context.Context, userID int64, tag, hash string) error {
+	key := s.userIndexKey(userID)
+
+	if err := s.client.Do(ctx, s.client.B().Sadd().Key(key).Member(tag+hash).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to index session for user %d: %w", userID, err)
+	}
+
+	return s.client.Do(ctx, s.client.B().Expire().Key(key).Seconds(int64(refreshTokenTTL.Seconds())).Build()).Error()
+}
+
+func (s *ValkeyStore) unindexToken(ctx context.Context, userID int64, tag, hash string) error {
+	key := s.userIndexKey(userID)
+
+	return s.client.Do(ctx, s.client.B().Srem().Key(key).Member(tag+hash).Build()).Error()
+}
+
+func (s *ValkeyStore) set(ctx context.Context, key string, rec record, ttl time.Duration) error {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal session record: %w", err)
+	}
+
+	err = s.client.Do(ctx, s.client.B().Set().Key(key).Value(string(payload)).Ex(ttl).Build()).Error()
+	if err != nil {
+		return fmt.Errorf("failed to persist session record: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ValkeyStore) get(ctx context.Context, key string) (record, error) {
+	raw, err := s.client.Do(ctx, s.client.B().Get().Key(key).Build()).ToString()
+	if err != nil {
+		if valkey.IsValkeyNil(err) {
+			return record{}, ErrNotFound
+		}
+		return record{}, fmt.Errorf("failed to load session record: %w", err)
+	}
+
+	var rec record
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return record{}, fmt.Errorf("failed to unmarshal session record: %w", err)
+	}
+
+	return rec, nil
+}
+
+func (s *ValkeyStore) del(ctx context.Context, key string) error {
+	return s.client.Do(ctx, s.client.B().Del().Key(key).Build()).Error()
+}
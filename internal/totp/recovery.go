@@ -0,0 +1,45 @@
+package totp
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	recoveryCodeAlpha  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567" // base32 alphabet, no padding
+)
+
+// GenerateRecoveryCodes returns recoveryCodeCount fresh one-time recovery codes. Callers are
+// responsible for bcrypt-hashing them before persisting and for returning the plaintext to the
+// user exactly once.
+func GenerateRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+
+	for i := range codes {
+		code, err := randomRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		codes[i] = code
+	}
+
+	return codes, nil
+}
+
+func randomRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for _, b := range buf {
+		sb.WriteByte(recoveryCodeAlpha[int(b)%len(recoveryCodeAlpha)])
+	}
+
+	return sb.String(), nil
+}
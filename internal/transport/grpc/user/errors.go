@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ozeemandias/auth/internal/auth"
+	"github.com/ozeemandias/auth/internal/model"
+	"github.com/ozeemandias/auth/internal/repository/passwordreset"
+	"github.com/ozeemandias/auth/internal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// errMap centralizes the mapping from domain errors to gRPC status codes, so handlers never
+// have to map a domain error to codes.* themselves.
+var errMap = map[error]codes.Code{
+	model.ErrNotFound:           codes.NotFound,
+	model.ErrAlreadyExists:      codes.AlreadyExists,
+	model.ErrInvalidCredentials: codes.Unauthenticated,
+	model.ErrInvalidTOTPCode:    codes.InvalidArgument,
+	session.ErrNotFound:         codes.Unauthenticated,
+	passwordreset.ErrNotFound:   codes.InvalidArgument,
+}
+
+func mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	for domainErr, code := range errMap {
+		if errors.Is(err, domainErr) {
+			return status.Error(code, err.Error())
+		}
+	}
+
+	return status.Errorf(codes.Internal, "internal error: %v", err)
+}
+
+func principalFromContext(ctx context.Context) (auth.Principal, error) {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, "missing principal")
+	}
+
+	return principal, nil
+}
+
+// deviceFromContext pulls a human-readable device/client identifier out of the incoming
+// gRPC metadata, falling back to "unknown" when the caller didn't send a user-agent.
+func deviceFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+
+	if ua := md.Get("user-agent"); len(ua) > 0 {
+		return ua[0]
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,87 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/internal/model"
+	userservice "github.com/ozeemandias/auth/internal/service/user"
+	"github.com/ozeemandias/auth/internal/session"
+	"github.com/ozeemandias/auth/pkg/user_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler is the thin gRPC-facing implementation of user_v1.UserV1Server: it translates
+// between proto and domain types and delegates every business decision to the service layer.
+type Handler struct {
+	user_v1.UnimplementedUserV1Server
+
+	service  *userservice.Service
+	resetter *userservice.PasswordResetter
+	sessions session.Store
+	totpKey  []byte
+}
+
+func NewHandler(service *userservice.Service, resetter *userservice.PasswordResetter, sessions session.Store, totpKey []byte) *Handler {
+	return &Handler{service: service, resetter: resetter, sessions: sessions, totpKey: totpKey}
+}
+
+func (h *Handler) Create(ctx context.Context, req *user_v1.CreateRequest) (*user_v1.CreateResponse, error) {
+	id, err := h.service.Create(ctx, req.Name, req.Email, req.Password, roleFromProto(req.Role))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.CreateResponse{Id: id}, nil
+}
+
+func (h *Handler) Get(ctx context.Context, req *user_v1.GetRequest) (*user_v1.GetResponse, error) {
+	u, err := h.service.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.GetResponse{
+		Id:        u.ID,
+		Name:      u.Name,
+		Email:     u.Email,
+		Role:      roleToProto(u.Role),
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	}, nil
+}
+
+func (h *Handler) Update(ctx context.Context, req *user_v1.UpdateRequest) (*emptypb.Empty, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Role != user_v1.Role_UNSPECIFIED && principal.Role != model.RoleAdmin {
+		return nil, status.Error(codes.PermissionDenied, "only an admin may change a user's role")
+	}
+
+	var name, email *string
+	if req.Name != nil {
+		name = &req.Name.Value
+	}
+	if req.Email != nil {
+		email = &req.Email.Value
+	}
+
+	if err := h.service.Update(ctx, req.GetId(), name, email, roleFromProto(req.Role)); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) Delete(ctx context.Context, req *user_v1.DeleteRequest) (*emptypb.Empty, error) {
+	if err := h.service.Delete(ctx, req.GetId()); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
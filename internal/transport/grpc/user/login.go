@@ -0,0 +1,86 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/internal/model"
+	"github.com/ozeemandias/auth/pkg/user_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) Login(ctx context.Context, req *user_v1.LoginRequest) (*user_v1.LoginResponse, error) {
+	u, err := h.service.VerifyPassword(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if u.HasTOTP() {
+		preAuthToken, err := h.sessions.IssuePreAuthToken(ctx, u.ID, int32(u.Role))
+		if err != nil {
+			return nil, mapError(err)
+		}
+
+		return &user_v1.LoginResponse{NeedsTotp: true, PreAuthToken: preAuthToken}, nil
+	}
+
+	return h.issueTokens(ctx, u.ID, u.Role)
+}
+
+func (h *Handler) Logout(ctx context.Context, req *user_v1.LogoutRequest) (*emptypb.Empty, error) {
+	if req.AllSessions {
+		principal, err := principalFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := h.sessions.RevokeAllForUser(ctx, principal.UserID); err != nil {
+			return nil, mapError(err)
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
+	if err := h.sessions.Revoke(ctx, req.RefreshToken); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) Refresh(ctx context.Context, req *user_v1.RefreshRequest) (*user_v1.RefreshResponse, error) {
+	tokens, err := h.sessions.Rotate(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.RefreshResponse{
+		AccessToken:      tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		AccessExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+	}, nil
+}
+
+func (h *Handler) WhoAmI(ctx context.Context, _ *emptypb.Empty) (*user_v1.WhoAmIResponse, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user_v1.WhoAmIResponse{Id: principal.UserID, Role: roleToProto(principal.Role)}, nil
+}
+
+func (h *Handler) issueTokens(ctx context.Context, userID int64, role model.Role) (*user_v1.LoginResponse, error) {
+	tokens, err := h.sessions.Create(ctx, userID, int32(role), deviceFromContext(ctx))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.LoginResponse{
+		AccessToken:      tokens.AccessToken,
+		RefreshToken:     tokens.RefreshToken,
+		AccessExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/pkg/user_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) RequestPasswordReset(ctx context.Context, req *user_v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	h.resetter.Request(ctx, req.Email)
+
+	return &emptypb.Empty{}, nil
+}
+
+// ConfirmPasswordReset sets the new password and revokes every existing session (access and
+// refresh tokens alike) for the account, so a session obtained before the reset stops working
+// immediately rather than lingering until its access token's TTL expires.
+func (h *Handler) ConfirmPasswordReset(ctx context.Context, req *user_v1.ConfirmPasswordResetRequest) (*emptypb.Empty, error) {
+	userID, err := h.resetter.Confirm(ctx, req.Token, req.NewPassword)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	if err := h.sessions.RevokeAllForUser(ctx, userID); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
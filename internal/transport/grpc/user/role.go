@@ -0,0 +1,28 @@
+package user
+
+import (
+	"github.com/ozeemandias/auth/internal/model"
+	"github.com/ozeemandias/auth/pkg/user_v1"
+)
+
+func roleFromProto(r user_v1.Role) model.Role {
+	switch r {
+	case user_v1.Role_USER:
+		return model.RoleUser
+	case user_v1.Role_ADMIN:
+		return model.RoleAdmin
+	default:
+		return model.RoleUnspecified
+	}
+}
+
+func roleToProto(r model.Role) user_v1.Role {
+	switch r {
+	case model.RoleUser:
+		return user_v1.Role_USER
+	case model.RoleAdmin:
+		return user_v1.Role_ADMIN
+	default:
+		return user_v1.Role_UNSPECIFIED
+	}
+}
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"context"
+
+	"github.com/ozeemandias/auth/pkg/user_v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) EnrollTOTP(ctx context.Context, _ *emptypb.Empty) (*user_v1.EnrollTOTPResponse, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, otpauthURI, err := h.service.EnrollTOTP(ctx, h.totpKey, principal.UserID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.EnrollTOTPResponse{Secret: secret, OtpauthUri: otpauthURI}, nil
+}
+
+func (h *Handler) ConfirmTOTP(ctx context.Context, req *user_v1.ConfirmTOTPRequest) (*user_v1.ConfirmTOTPResponse, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := h.service.ConfirmTOTP(ctx, h.totpKey, principal.UserID, req.Code)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &user_v1.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *Handler) DisableTOTP(ctx context.Context, req *user_v1.DisableTOTPRequest) (*emptypb.Empty, error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.DisableTOTP(ctx, h.totpKey, principal.UserID, req.Code); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) VerifyTOTP(ctx context.Context, req *user_v1.VerifyTOTPRequest) (*user_v1.LoginResponse, error) {
+	preAuth, err := h.sessions.ConsumePreAuthToken(ctx, req.PreAuthToken)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	u, err := h.service.VerifyTOTPCode(ctx, h.totpKey, preAuth.UserID, req.Code)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return h.issueTokens(ctx, u.ID, u.Role)
+}
@@ -0,0 +1,107 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ozeemandias/auth/internal/auth"
+	"github.com/ozeemandias/auth/internal/model"
+	"github.com/ozeemandias/auth/internal/session"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Auth returns a unary interceptor that enforces the per-method policy map: it resolves the
+// bearer access token (when present) into a session.Session, stuffs an auth.Principal into the
+// request context, and rejects the call before it reaches the handler if the policy isn't met.
+func Auth(store session.Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		req_ := requirementFor(info.FullMethod)
+		if req_ == public {
+			return handler(ctx, req)
+		}
+
+		principal, err := resolvePrincipal(ctx, store)
+		if err != nil {
+			return nil, err
+		}
+
+		switch req_ {
+		case authenticated:
+			// any resolved principal is enough
+		case adminOnly:
+			if !isAdmin(principal.Role) {
+				return nil, status.Error(codes.PermissionDenied, "admin role required")
+			}
+		case ownerOrAdmin:
+			if !isAdmin(principal.Role) {
+				getter, ok := req.(idGetter)
+				if !ok || getter.GetId() != principal.UserID {
+					return nil, status.Error(codes.PermissionDenied, "not the resource owner")
+				}
+			}
+		}
+
+		return handler(auth.NewContextWithPrincipal(ctx, principal), req)
+	}
+}
+
+// AuthStream is the streaming counterpart of Auth, used once streaming RPCs are introduced.
+// Beyond requiring a valid principal it checks only adminOnly: ownerOrAdmin methods admit any
+// authenticated caller, as no request message is inspected here.
+func AuthStream(store session.Store) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		req_ := requirementFor(info.FullMethod)
+		if req_ == public {
+			return handler(srv, ss)
+		}
+
+		principal, err := resolvePrincipal(ss.Context(), store)
+		if err != nil {
+			return err
+		}
+
+		if req_ == adminOnly && !isAdmin(principal.Role) {
+			return status.Error(codes.PermissionDenied, "admin role required")
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: auth.NewContextWithPrincipal(ss.Context(), principal)})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func resolvePrincipal(ctx context.Context, store session.Store) (auth.Principal, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	const prefix = "Bearer "
+	if !strings.HasPrefix(values[0], prefix) {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, "malformed authorization header")
+	}
+
+	accessToken := strings.TrimPrefix(values[0], prefix)
+
+	sess, err := store.Resolve(ctx, accessToken)
+	if err != nil {
+		return auth.Principal{}, status.Error(codes.Unauthenticated, "invalid or expired access token")
+	}
+
+	return auth.Principal{UserID: sess.UserID, Role: model.Role(sess.Role)}, nil
+}
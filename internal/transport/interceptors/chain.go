@@ -0,0 +1,27 @@
+package interceptors
+
+import (
+	"github.com/ozeemandias/auth/internal/session"
+	"github.com/ozeemandias/auth/internal/validator"
+	"google.golang.org/grpc"
+)
+
+// ServerOptions returns the grpc.ServerOption pipeline shared by every RPC: request-id
+// injection, structured logging and panic recovery run for every unary call, field validation
+// rejects malformed requests next, and the auth/RBAC interceptor enforcing the per-method
+// policy map (see policy.go) runs last, right before the handler. Streaming calls go through
+// AuthStream only.
+func ServerOptions(store session.Store) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			RequestID(),
+			Logging(),
+			Recovery(),
+			validator.UnaryServerInterceptor(),
+			Auth(store),
+		),
+		grpc.ChainStreamInterceptor(
+			AuthStream(store),
+		),
+	}
+}
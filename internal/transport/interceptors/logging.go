@@ -0,0 +1,26 @@
+package interceptors
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Logging logs the method, duration, request id and outcome of every unary call.
+func Logging() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		requestID, _ := RequestIDFromContext(ctx)
+		code := status.Code(err)
+
+		log.Printf("method=%s request_id=%s duration=%s code=%s", info.FullMethod, requestID, time.Since(start), code)
+
+		return resp, err
+	}
+}
@@ -0,0 +1,59 @@
+package interceptors
+
+import "github.com/ozeemandias/auth/internal/model"
+
+// requirement describes who is allowed to call a given RPC.
+type requirement int
+
+const (
+	// public methods bypass authentication entirely.
+	public requirement = iota
+	// authenticated methods require a valid principal but place no further restriction on it.
+	authenticated
+	// ownerOrAdmin methods require a valid principal that is either the ADMIN role or the
+	// subject of the request (matched against the request's GetId()).
+	ownerOrAdmin
+	// adminOnly methods require a valid principal with the ADMIN role.
+	adminOnly
+)
+
+// idGetter is satisfied by every request message carrying a numeric id, which is how
+// ownerOrAdmin compares the caller against the resource being acted on.
+type idGetter interface {
+	GetId() int64
+}
+
+// policy maps a full gRPC method name (service/method) to its access requirement. Anything
+// not listed here defaults to adminOnly, so adding a new RPC without a policy entry fails
+// closed rather than open.
+var policy = map[string]requirement{
+	"/user_v1.UserV1/Login":                public,
+	"/user_v1.UserV1/Refresh":              public,
+	"/user_v1.UserV1/VerifyTOTP":           public,
+	"/user_v1.UserV1/RequestPasswordReset": public,
+	"/user_v1.UserV1/ConfirmPasswordReset": public,
+
+	"/user_v1.UserV1/Create": adminOnly,
+	"/user_v1.UserV1/Delete": adminOnly,
+
+	"/user_v1.UserV1/Get":    ownerOrAdmin,
+	"/user_v1.UserV1/Update": ownerOrAdmin,
+
+	"/user_v1.UserV1/Logout":      authenticated,
+	"/user_v1.UserV1/WhoAmI":      authenticated,
+	"/user_v1.UserV1/EnrollTOTP":  authenticated,
+	"/user_v1.UserV1/ConfirmTOTP": authenticated,
+	"/user_v1.UserV1/DisableTOTP": authenticated,
+}
+
+func requirementFor(fullMethod string) requirement {
+	if req, ok := policy[fullMethod]; ok {
+		return req
+	}
+
+	return adminOnly
+}
+
+func isAdmin(role model.Role) bool {
+	return role == model.RoleAdmin
+}
@@ -0,0 +1,24 @@
+package interceptors
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Recovery turns a panic in a handler into codes.Internal instead of crashing the process.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Printf("panic recovered in %s: %v", info.FullMethod, r)
+				err = status.Errorf(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,33 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type requestIDCtxKey struct{}
+
+// RequestIDFromContext returns the request id injected by RequestID, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDCtxKey{}).(string)
+	return id, ok
+}
+
+// RequestID stamps every incoming call with a request id, reusing one supplied by the
+// caller via the "x-request-id" metadata key or minting a fresh uuid otherwise.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := uuid.NewString()
+
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-request-id"); len(values) > 0 && values[0] != "" {
+				requestID = values[0]
+			}
+		}
+
+		return handler(context.WithValue(ctx, requestIDCtxKey{}, requestID), req)
+	}
+}
@@ -0,0 +1,42 @@
+package validator
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor validates every incoming request before it reaches the handler,
+// rejecting it with codes.InvalidArgument and a google.rpc.BadRequest detail listing every
+// failing field at once, rather than stopping at the first one.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if violations := Validate(req); len(violations) > 0 {
+			return nil, statusFromViolations(violations)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func statusFromViolations(violations []FieldViolation) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "request failed validation")
+
+	withDetails, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
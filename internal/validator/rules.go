@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ozeemandias/auth/pkg/user_v1"
+)
+
+const (
+	maxNameLen     = 64
+	maxEmailLen    = 254
+	minPasswordLen = 8
+)
+
+// emailRE is an RFC-5322-lite check: good enough to reject obvious garbage without the full
+// grammar's edge cases (quoted strings, comments, ...).
+var emailRE = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_` + "`" + `{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$`)
+
+// Validate dispatches on the concrete request type and returns every field violation found,
+// or nil if req passes (or isn't a type this package knows how to validate).
+func Validate(req interface{}) []FieldViolation {
+	switch r := req.(type) {
+	case *user_v1.CreateRequest:
+		return validateCreate(r.GetName(), r.GetEmail(), r.GetPassword(), r.GetRole())
+	case *user_v1.UpdateRequest:
+		return validateUpdate(r)
+	case *user_v1.GetRequest:
+		return validateID(r.GetId())
+	case *user_v1.DeleteRequest:
+		return validateID(r.GetId())
+	case *user_v1.ConfirmPasswordResetRequest:
+		return validateConfirmPasswordReset(r.GetNewPassword())
+	default:
+		return nil
+	}
+}
+
+func validateID(id int64) []FieldViolation {
+	if id <= 0 {
+		return []FieldViolation{{Field: "id", Description: "must be a positive integer"}}
+	}
+
+	return nil
+}
+
+func validateCreate(name, email, password string, role user_v1.Role) []FieldViolation {
+	var violations []FieldViolation
+
+	if v := validateName(name); v != nil {
+		violations = append(violations, *v)
+	}
+	if v := validateEmail(email); v != nil {
+		violations = append(violations, *v)
+	}
+	if v := validatePassword(password); v != nil {
+		violations = append(violations, *v)
+	}
+	if v := validateRole(role); v != nil {
+		violations = append(violations, *v)
+	}
+
+	return violations
+}
+
+func validateUpdate(r *user_v1.UpdateRequest) []FieldViolation {
+	var violations []FieldViolation
+
+	if r.GetId() <= 0 {
+		violations = append(violations, FieldViolation{Field: "id", Description: "must be a positive integer"})
+	}
+
+	if r.Name == nil && r.Email == nil && r.Role == user_v1.Role_UNSPECIFIED {
+		violations = append(violations, FieldViolation{Field: "*", Description: "at least one of name, email or role must be set"})
+		return violations
+	}
+
+	if r.Name != nil {
+		if v := validateName(r.Name.Value); v != nil {
+			violations = append(violations, *v)
+		}
+	}
+	if r.Email != nil {
+		if v := validateEmail(r.Email.Value); v != nil {
+			violations = append(violations, *v)
+		}
+	}
+	if r.Role != user_v1.Role_UNSPECIFIED {
+		if v := validateRole(r.Role); v != nil {
+			violations = append(violations, *v)
+		}
+	}
+
+	return violations
+}
+
+func validateConfirmPasswordReset(newPassword string) []FieldViolation {
+	v := validatePassword(newPassword)
+	if v == nil {
+		return nil
+	}
+
+	v.Field = "new_password"
+
+	return []FieldViolation{*v}
+}
+
+func validateName(name string) *FieldViolation {
+	trimmed := strings.TrimSpace(name)
+	if n := utf8.RuneCountInString(trimmed); n < 1 || n > maxNameLen {
+		return &FieldViolation{Field: "name", Description: "must be 1-64 characters after trimming whitespace"}
+	}
+
+	return nil
+}
+
+func validateEmail(email string) *FieldViolation {
+	if len(email) > maxEmailLen || !emailRE.MatchString(email) {
+		return &FieldViolation{Field: "email", Description: "must be a valid email address no longer than 254 characters"}
+	}
+
+	return nil
+}
+
+func validatePassword(password string) *FieldViolation {
+	if utf8.RuneCountInString(password) < minPasswordLen {
+		return &FieldViolation{Field: "password", Description: "must be at least 8 characters long"}
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+
+	if !hasLetter || !hasDigit {
+		return &FieldViolation{Field: "password", Description: "must contain at least one letter and one digit"}
+	}
+
+	return nil
+}
+
+func validateRole(role user_v1.Role) *FieldViolation {
+	if _, ok := user_v1.Role_name[int32(role)]; !ok || role == user_v1.Role_UNSPECIFIED {
+		return &FieldViolation{Field: "role", Description: "must be a defined, non-UNSPECIFIED role"}
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+// Package validator implements protoc-gen-validate-style request validation by hand, since
+// this snapshot has no code generation step. Unlike the generated Validate() error method,
+// Validate here collects every failing field so the interceptor can report all of them at once.
+package validator
+
+// FieldViolation describes a single field that failed validation.
+type FieldViolation struct {
+	Field       string
+	Description string
+}